@@ -0,0 +1,90 @@
+package result
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// jsonResult is the wire representation used by UnmarshalJSON:
+// {"ok":true,"value":...} for a success, {"ok":false,"error":"..."} for a
+// failure.
+type jsonResult[S any] struct {
+	OK    bool   `json:"ok"`
+	Value S      `json:"value"`
+	Error string `json:"error,omitempty"`
+}
+
+// jsonSuccess and jsonFailure are the two shapes MarshalJSON actually
+// produces. Keeping Value out of the failure shape (rather than reusing
+// jsonResult with omitempty) means a zero success value, e.g.
+// Success(0) or Success(""), still round-trips as {"ok":true,"value":...}
+// instead of being silently dropped.
+type jsonSuccess[S any] struct {
+	OK    bool `json:"ok"`
+	Value S    `json:"value"`
+}
+
+type jsonFailure struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// MarshalJSON implements json.Marshaler, encoding a success as
+// {"ok":true,"value":...} and a failure as {"ok":false,"error":"..."}.
+//
+// Example:
+//
+//	r := result.Success(42)
+//	data, _ := json.Marshal(r)
+//	// data is {"ok":true,"value":42}
+func (r Result[S]) MarshalJSON() ([]byte, error) {
+	if r.IsFailure() {
+		return json.Marshal(jsonFailure{OK: false, Error: r.failure.Error()})
+	}
+	return json.Marshal(jsonSuccess[S]{OK: true, Value: r.success})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the
+// {"ok":true,"value":...} / {"ok":false,"error":"..."} representation
+// produced by MarshalJSON. A decoded failure's error is reconstructed with
+// errors.New, so it no longer matches the original error value or type.
+func (r *Result[S]) UnmarshalJSON(data []byte) error {
+	var wire jsonResult[S]
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	if wire.OK {
+		*r = Success(wire.Value)
+		return nil
+	}
+
+	*r = Failure[S](errors.New(wire.Error))
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, producing the success
+// value's text form, or the failure's error string prefixed with "error: ".
+//
+// MarshalText is useful for Results flowing through text-based formats
+// (query parameters, env vars, CSV cells) where the tagged JSON shape
+// doesn't apply. S must itself implement encoding.TextMarshaler, or be a
+// fmt.Stringer, or be a string.
+func (r Result[S]) MarshalText() ([]byte, error) {
+	if r.IsFailure() {
+		return []byte("error: " + r.failure.Error()), nil
+	}
+
+	switch v := any(r.success).(type) {
+	case encoding.TextMarshaler:
+		return v.MarshalText()
+	case fmt.Stringer:
+		return []byte(v.String()), nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, fmt.Errorf("result: %T does not implement encoding.TextMarshaler, fmt.Stringer, or string", r.success)
+	}
+}
@@ -0,0 +1,129 @@
+package result_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/sillen102/result"
+)
+
+func TestResultMarshalJSONSuccess(t *testing.T) {
+	r := result.Success(42)
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(data) != `{"ok":true,"value":42}` {
+		t.Errorf("Expected {\"ok\":true,\"value\":42}, got %s", data)
+	}
+}
+
+func TestResultMarshalJSONZeroValueSuccess(t *testing.T) {
+	data, err := json.Marshal(result.Success(0))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(data) != `{"ok":true,"value":0}` {
+		t.Errorf("Expected {\"ok\":true,\"value\":0}, got %s", data)
+	}
+
+	data, err = json.Marshal(result.Success(""))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(data) != `{"ok":true,"value":""}` {
+		t.Errorf("Expected {\"ok\":true,\"value\":\"\"}, got %s", data)
+	}
+}
+
+func TestResultMarshalJSONFailure(t *testing.T) {
+	r := result.Failure[int](errors.New("not found"))
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(data) != `{"ok":false,"error":"not found"}` {
+		t.Errorf("Expected {\"ok\":false,\"error\":\"not found\"}, got %s", data)
+	}
+}
+
+func TestResultUnmarshalJSONSuccess(t *testing.T) {
+	var r result.Result[int]
+	if err := json.Unmarshal([]byte(`{"ok":true,"value":42}`), &r); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !r.IsSuccess() || r.GetSuccess() != 42 {
+		t.Errorf("Expected success 42, got %v", r)
+	}
+}
+
+func TestResultUnmarshalJSONFailure(t *testing.T) {
+	var r result.Result[int]
+	if err := json.Unmarshal([]byte(`{"ok":false,"error":"not found"}`), &r); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !r.IsFailure() || r.GetFailure().Error() != "not found" {
+		t.Errorf("Expected failure \"not found\", got %v", r)
+	}
+}
+
+func TestResultJSONRoundTrip(t *testing.T) {
+	r := result.Success("hello")
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var decoded result.Result[string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !decoded.IsSuccess() || decoded.GetSuccess() != "hello" {
+		t.Errorf("Expected success \"hello\", got %v", decoded)
+	}
+}
+
+func TestResultMarshalTextSuccess(t *testing.T) {
+	r := result.Success("hello")
+
+	data, err := r.MarshalText()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(data) != "hello" {
+		t.Errorf("Expected \"hello\", got %s", data)
+	}
+}
+
+func TestResultMarshalTextFailure(t *testing.T) {
+	r := result.Failure[string](errors.New("not found"))
+
+	data, err := r.MarshalText()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(data) != "error: not found" {
+		t.Errorf("Expected \"error: not found\", got %s", data)
+	}
+}
+
+func TestResultMarshalTextUnsupportedType(t *testing.T) {
+	r := result.Success(struct{ X int }{X: 1})
+
+	if _, err := r.MarshalText(); err == nil {
+		t.Error("Expected an error for a type that doesn't implement TextMarshaler, Stringer, or string")
+	}
+}
@@ -0,0 +1,215 @@
+package result
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Future represents a Result[S] that will be resolved asynchronously.
+//
+// The zero value of a Future is valid and behaves as an already-resolved
+// Future holding an unknown value: awaiting it immediately returns
+// Success with the zero value of S. This makes Future ergonomic to use
+// as a struct field or map value without explicit initialization.
+//
+// Future contains a mutex and must not be copied after first use; the
+// constructors and combinators in this package always hand back a
+// *Future[S] for that reason.
+type Future[S any] struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	done     bool
+	resolved Result[S]
+}
+
+// Async spawns a goroutine that runs f and returns a Future[S] that
+// resolves with the Result produced by f. If f panics, the panic is
+// recovered and converted into a Failure so that a misbehaving producer
+// can never take down the awaiting goroutine.
+//
+// Example:
+//
+//	future := result.Async(func() (int, error) {
+//		return slowComputation()
+//	})
+//
+//	r := future.Await(context.Background())
+func Async[S any](f func() (S, error)) *Future[S] {
+	future := newFuture[S]()
+
+	go func() {
+		defer func() {
+			if rec := recover(); rec != nil {
+				future.resolve(Failure[S](fmt.Errorf("result: panic in Async producer: %v", rec)))
+			}
+		}()
+
+		future.resolve(Try(f()))
+	}()
+
+	return future
+}
+
+func newFuture[S any]() *Future[S] {
+	future := &Future[S]{}
+	future.cond = sync.NewCond(&future.mu)
+	return future
+}
+
+func (f *Future[S]) resolve(r Result[S]) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.done {
+		return
+	}
+
+	f.resolved = r
+	f.done = true
+
+	if f.cond != nil {
+		f.cond.Broadcast()
+	}
+}
+
+// Await blocks until the Future resolves and returns its Result, or
+// returns a Failure wrapping ctx.Err() if ctx is cancelled first.
+//
+// Await is safe to call from multiple goroutines concurrently; every
+// awaiter observes the same Result once it resolves.
+func (f *Future[S]) Await(ctx context.Context) Result[S] {
+	// A zero-value Future has no condition variable and is treated as an
+	// already-resolved Result holding the zero value of S.
+	if f.cond == nil {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if !f.done {
+			return Success(f.resolved.success)
+		}
+		return f.resolved
+	}
+
+	done := make(chan Result[S], 1)
+	go func() {
+		f.mu.Lock()
+		for !f.done {
+			f.cond.Wait()
+		}
+		r := f.resolved
+		f.mu.Unlock()
+		done <- r
+	}()
+
+	select {
+	case r := <-done:
+		return r
+	case <-ctx.Done():
+		return Failure[S](ctx.Err())
+	}
+}
+
+// Then returns a new Future that resolves with transform applied to this
+// Future's successful value, without blocking the calling goroutine.
+// If this Future resolves to a failure, the failure propagates
+// unchanged and transform is never called.
+func (f *Future[S]) Then(transform func(S) S) *Future[S] {
+	future := newFuture[S]()
+
+	go func() {
+		r := f.Await(context.Background())
+		future.resolve(r.Then(transform))
+	}()
+
+	return future
+}
+
+// FutureTransform returns a new Future[NS] that resolves with f applied
+// to the successful value of future, without blocking the calling
+// goroutine. A failure on future propagates unchanged.
+func FutureTransform[S, NS any](future *Future[S], f func(S) NS) *Future[NS] {
+	result := newFuture[NS]()
+
+	go func() {
+		r := future.Await(context.Background())
+		result.resolve(Transform(r, f))
+	}()
+
+	return result
+}
+
+// FutureThenWith chains a function returning a *Future[S] onto the
+// successful value of future, flattening the result into a single
+// Future[S] rather than a Future of a Future.
+func FutureThenWith[S any](future *Future[S], f func(S) *Future[S]) *Future[S] {
+	result := newFuture[S]()
+
+	go func() {
+		r := future.Await(context.Background())
+		if r.IsFailure() {
+			result.resolve(r)
+			return
+		}
+		next := f(r.GetSuccess())
+		result.resolve(next.Await(context.Background()))
+	}()
+
+	return result
+}
+
+// All returns a Future that resolves once every Future in futures has
+// resolved, combining their values into a slice in the same order. If any
+// of them fails, the returned Future resolves to the failure of the
+// earliest one in argument order. It does not resolve early on a failure:
+// it awaits the futures one by one and waits for all of them to resolve.
+func All[S any](futures ...*Future[S]) *Future[[]S] {
+	result := newFuture[[]S]()
+
+	go func() {
+		values := make([]S, len(futures))
+		var firstErr error
+
+		for i := range futures {
+			r := futures[i].Await(context.Background())
+			if r.IsFailure() {
+				if firstErr == nil {
+					firstErr = r.GetFailure()
+				}
+				continue
+			}
+			values[i] = r.GetSuccess()
+		}
+
+		if firstErr != nil {
+			result.resolve(Failure[[]S](firstErr))
+			return
+		}
+		result.resolve(Success(values))
+	}()
+
+	return result
+}
+
+// Race returns a Future that resolves with the Result of whichever
+// Future in futures resolves first, success or failure.
+func Race[S any](futures ...*Future[S]) *Future[S] {
+	result := newFuture[S]()
+
+	if len(futures) == 0 {
+		result.resolve(Failure[S](fmt.Errorf("result: Race called with no futures")))
+		return result
+	}
+
+	done := make(chan Result[S], len(futures))
+	for i := range futures {
+		go func(fut *Future[S]) {
+			done <- fut.Await(context.Background())
+		}(futures[i])
+	}
+
+	go func() {
+		result.resolve(<-done)
+	}()
+
+	return result
+}
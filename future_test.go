@@ -0,0 +1,224 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sillen102/result"
+)
+
+func TestAsyncAwait(t *testing.T) {
+	future := result.Async(func() (int, error) {
+		return 42, nil
+	})
+
+	r := future.Await(context.Background())
+
+	if !r.IsSuccess() {
+		t.Error("Expected future result to be success")
+	}
+
+	if r.GetSuccess() != 42 {
+		t.Errorf("Expected success value to be 42, got %v", r.GetSuccess())
+	}
+}
+
+func TestAsyncFailure(t *testing.T) {
+	testErr := errors.New("async error")
+	future := result.Async(func() (int, error) {
+		return 0, testErr
+	})
+
+	r := future.Await(context.Background())
+
+	if !r.IsFailure() {
+		t.Error("Expected future result to be failure")
+	}
+
+	if !errors.Is(testErr, r.GetFailure()) {
+		t.Errorf("Expected failure value to be %v, got %v", testErr, r.GetFailure())
+	}
+}
+
+func TestAsyncPanicRecovery(t *testing.T) {
+	future := result.Async(func() (int, error) {
+		panic("boom")
+	})
+
+	r := future.Await(context.Background())
+
+	if !r.IsFailure() {
+		t.Error("Expected future result to be failure after panic")
+	}
+
+	if !strings.Contains(r.GetFailure().Error(), "boom") {
+		t.Errorf("Expected failure to mention panic value, got %v", r.GetFailure())
+	}
+}
+
+func TestFutureAwaitCancellation(t *testing.T) {
+	block := make(chan struct{})
+	future := result.Async(func() (int, error) {
+		<-block
+		return 42, nil
+	})
+	defer close(block)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	r := future.Await(ctx)
+
+	if !r.IsFailure() {
+		t.Error("Expected future result to be failure when ctx is cancelled")
+	}
+
+	if !errors.Is(r.GetFailure(), context.DeadlineExceeded) {
+		t.Errorf("Expected failure to be context.DeadlineExceeded, got %v", r.GetFailure())
+	}
+}
+
+func TestFutureConcurrentAwaiters(t *testing.T) {
+	future := result.Async(func() (int, error) {
+		time.Sleep(5 * time.Millisecond)
+		return 7, nil
+	})
+
+	const awaiters = 20
+	var wg sync.WaitGroup
+	wg.Add(awaiters)
+
+	for i := 0; i < awaiters; i++ {
+		go func() {
+			defer wg.Done()
+			r := future.Await(context.Background())
+			if !r.IsSuccess() || r.GetSuccess() != 7 {
+				t.Errorf("Expected all awaiters to see Success(7), got %v", r)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestFutureZeroValue(t *testing.T) {
+	var future result.Future[int]
+
+	r := future.Await(context.Background())
+
+	if !r.IsSuccess() {
+		t.Error("Expected zero-value future to resolve as success")
+	}
+
+	if r.GetSuccess() != 0 {
+		t.Errorf("Expected zero-value future success to be 0, got %v", r.GetSuccess())
+	}
+}
+
+func TestFutureThen(t *testing.T) {
+	future := result.Async(func() (int, error) {
+		return 21, nil
+	})
+
+	doubled := future.Then(func(i int) int {
+		return i * 2
+	})
+
+	r := doubled.Await(context.Background())
+
+	if !r.IsSuccess() || r.GetSuccess() != 42 {
+		t.Errorf("Expected Then result to be Success(42), got %v", r)
+	}
+}
+
+func TestFutureTransform(t *testing.T) {
+	future := result.Async(func() (int, error) {
+		return 42, nil
+	})
+
+	transformed := result.FutureTransform(future, func(i int) string {
+		return "value"
+	})
+
+	r := transformed.Await(context.Background())
+
+	if !r.IsSuccess() || r.GetSuccess() != "value" {
+		t.Errorf("Expected FutureTransform result to be Success(\"value\"), got %v", r)
+	}
+}
+
+func TestFutureThenWith(t *testing.T) {
+	future := result.Async(func() (int, error) {
+		return 10, nil
+	})
+
+	chained := result.FutureThenWith(future, func(i int) *result.Future[int] {
+		return result.Async(func() (int, error) {
+			return i + 1, nil
+		})
+	})
+
+	r := chained.Await(context.Background())
+
+	if !r.IsSuccess() || r.GetSuccess() != 11 {
+		t.Errorf("Expected FutureThenWith result to be Success(11), got %v", r)
+	}
+}
+
+func TestAllFailFast(t *testing.T) {
+	testErr := errors.New("second future failed")
+
+	f1 := result.Async(func() (int, error) { return 1, nil })
+	f2 := result.Async(func() (int, error) { return 0, testErr })
+	f3 := result.Async(func() (int, error) { return 3, nil })
+
+	all := result.All(f1, f2, f3)
+	r := all.Await(context.Background())
+
+	if !r.IsFailure() {
+		t.Error("Expected All result to be failure when one future fails")
+	}
+
+	if !errors.Is(testErr, r.GetFailure()) {
+		t.Errorf("Expected failure to be %v, got %v", testErr, r.GetFailure())
+	}
+}
+
+func TestAllSuccess(t *testing.T) {
+	f1 := result.Async(func() (int, error) { return 1, nil })
+	f2 := result.Async(func() (int, error) { return 2, nil })
+	f3 := result.Async(func() (int, error) { return 3, nil })
+
+	all := result.All(f1, f2, f3)
+	r := all.Await(context.Background())
+
+	if !r.IsSuccess() {
+		t.Error("Expected All result to be success")
+	}
+
+	values := r.GetSuccess()
+	if len(values) != 3 || values[0] != 1 || values[1] != 2 || values[2] != 3 {
+		t.Errorf("Expected [1 2 3], got %v", values)
+	}
+}
+
+func TestRace(t *testing.T) {
+	slow := result.Async(func() (int, error) {
+		time.Sleep(20 * time.Millisecond)
+		return 1, nil
+	})
+	fast := result.Async(func() (int, error) {
+		return 2, nil
+	})
+
+	winner := result.Race(slow, fast)
+	r := winner.Await(context.Background())
+
+	if !r.IsSuccess() || r.GetSuccess() != 2 {
+		t.Errorf("Expected the fast future to win the race with Success(2), got %v", r)
+	}
+}
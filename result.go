@@ -44,6 +44,12 @@ func (r Result[S]) GetSuccessOrElse(defaultValue S) S {
 	return defaultValue
 }
 
+// GetSuccessOr is an alias for GetSuccessOrElse, returning the success value
+// or defaultValue if the Result is a failure.
+func (r Result[S]) GetSuccessOr(defaultValue S) S {
+	return r.GetSuccessOrElse(defaultValue)
+}
+
 // Try converts a typical Go function return pattern (value, error) into a Result
 // This is useful when you have a function that can return an error and you want to convert it into a Result.
 // Example:
@@ -68,33 +74,30 @@ func Try[S any](value S, err error) Result[S] {
 	return Success(value)
 }
 
-// ThenTry is a method that converts a typical Go function return pattern (value, error) into a Result on the Result type.
-// This is useful when you have a function that can return an error and you want to use it with the Result type.
+// ThenTry applies f to the successful value of the Result if it is a success
+// and returns the Result returned by f, keeping the same success type. A
+// Result that is already a failure is returned unchanged and f is never
+// called.
+//
 // Example:
 //
-//	func returnsError() (value, error) {
-//		...
+//	func canReturnError(p Person) result.Result[Person] {
+//		p, err := returnsError(p)
+//		if err != nil {
+//			return result.Failure[Person](err)
+//		}
+//		return result.Success(p)
 //	}
 //
 //	func main() {
-//		value, err := returnsError()
-//		result := result.Success(Person{Name: "John", Age: 30}).Try(returnsError())
-//		if result.IsSuccess() {
-//			fmt.Println("Success:", result.GetSuccess())
-//		} else {
-//			fmt.Println("Error:", result.GetFailure())
-//		}
+//		r := result.Success(Person{Name: "John", Age: 30})
+//		chained := r.ThenTry(canReturnError)
 //	}
-func (r Result[S]) ThenTry(value S, err error) Result[S] {
+func (r Result[S]) ThenTry(f func(S) Result[S]) Result[S] {
 	if r.IsFailure() {
 		return r
 	}
-
-	if err != nil {
-		return Failure[S](err)
-	}
-	
-	return Success(value)
+	return f(r.GetSuccess())
 }
 
 // Transform applies a function to the successful value of the Result if it is a success
@@ -174,6 +177,14 @@ func TransformWith[S, NS any](r Result[S], f func(S) Result[NS]) Result[NS] {
 	return f(r.GetSuccess())
 }
 
+// ThenWith is an alias for TransformWith, applying f to the successful
+// value of r and flattening the Result it returns into a Result[NS]. If r
+// is a failure, its error is carried over unchanged into the returned
+// Result[NS] and f is never called.
+func ThenWith[S, NS any](r Result[S], f func(S) Result[NS]) Result[NS] {
+	return TransformWith(r, f)
+}
+
 // ThenWith applies a function to the successful value of the Result if it is a success
 // and returns the Result returned by the function.
 //
@@ -200,3 +211,114 @@ func (r Result[S]) ThenWith(f func(S) Result[S]) Result[S] {
 	}
 	return f(r.GetSuccess())
 }
+
+// Match calls onSuccess with the successful value if the Result is a success,
+// or onFailure with the failure value if the Result is a failure. Exactly one
+// of the two functions is called.
+//
+// Match is useful when you want to handle both branches of a Result for
+// their side effects and don't need to return a value, e.g. logging.
+//
+// Example:
+//
+//	r := result.Success(42)
+//	r.Match(
+//		func(value int) {
+//			fmt.Println("Success:", value)
+//		},
+//		func(err error) {
+//			fmt.Println("Failure:", err)
+//		},
+//	)
+func (r Result[S]) Match(onSuccess func(S), onFailure func(error)) {
+	if r.IsFailure() {
+		onFailure(r.GetFailure())
+		return
+	}
+	onSuccess(r.GetSuccess())
+}
+
+// Fold reduces a Result[S] to a single value of type R by calling onSuccess
+// with the successful value or onFailure with the failure value. Unlike
+// Match, Fold returns a value, making it useful when both branches need to
+// converge on a common type.
+//
+// Example:
+//
+//	r := result.Success(42)
+//	message := result.Fold(r,
+//		func(value int) string { return fmt.Sprintf("got %d", value) },
+//		func(err error) string { return "failed: " + err.Error() },
+//	)
+func Fold[S, R any](r Result[S], onSuccess func(S) R, onFailure func(error) R) R {
+	if r.IsFailure() {
+		return onFailure(r.GetFailure())
+	}
+	return onSuccess(r.GetSuccess())
+}
+
+// MapBoth transforms a Result[S] into a Result[NS] by applying sf to the
+// successful value or ef to the failure value, depending on which branch r
+// is in.
+//
+// Example:
+//
+//	r := result.Success(42)
+//	mapped := result.MapBoth(r,
+//		func(value int) string { return fmt.Sprintf("value: %d", value) },
+//		func(err error) error { return fmt.Errorf("wrapped: %w", err) },
+//	)
+func MapBoth[S, NS any](r Result[S], sf func(S) NS, ef func(error) error) Result[NS] {
+	if r.IsFailure() {
+		return Failure[NS](ef(r.GetFailure()))
+	}
+	return Success(sf(r.GetSuccess()))
+}
+
+// MapError applies f to the failure value of the Result if it is a failure,
+// leaving a successful Result unchanged. This is useful for enriching or
+// wrapping errors as they propagate, e.g. adding context with fmt.Errorf.
+//
+// Example:
+//
+//	r := result.Failure[int](errors.New("not found"))
+//	wrapped := result.MapError(r, func(err error) error {
+//		return fmt.Errorf("lookup failed: %w", err)
+//	})
+func MapError[S any](r Result[S], f func(error) error) Result[S] {
+	if r.IsFailure() {
+		return Failure[S](f(r.GetFailure()))
+	}
+	return r
+}
+
+// Recover converts a failed Result into a successful one by applying f to
+// the failure value to produce a fallback success value. A successful
+// Result is returned unchanged.
+//
+// Example:
+//
+//	r := result.Failure[int](errors.New("not found"))
+//	recovered := result.Recover(r, func(err error) int { return 0 })
+func Recover[S any](r Result[S], f func(error) S) Result[S] {
+	if r.IsFailure() {
+		return Success(f(r.GetFailure()))
+	}
+	return r
+}
+
+// RecoverWith is like Recover, but f itself returns a Result, allowing the
+// fallback to fail as well. A successful Result is returned unchanged.
+//
+// Example:
+//
+//	r := result.Failure[int](errors.New("not found"))
+//	recovered := result.RecoverWith(r, func(err error) result.Result[int] {
+//		return result.Try(loadDefault())
+//	})
+func RecoverWith[S any](r Result[S], f func(error) Result[S]) Result[S] {
+	if r.IsFailure() {
+		return f(r.GetFailure())
+	}
+	return r
+}
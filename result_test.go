@@ -2,6 +2,7 @@ package result_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/sillen102/result"
@@ -296,6 +297,122 @@ func TestMatch(t *testing.T) {
 	}
 }
 
+func TestFold(t *testing.T) {
+	// Test Fold with success
+	r := result.Success(42)
+	message := result.Fold(r,
+		func(i int) string { return "ok" },
+		func(err error) string { return "err" },
+	)
+	if message != "ok" {
+		t.Errorf("Expected 'ok', got %v", message)
+	}
+
+	// Test Fold with failure
+	testErr := errors.New("test error")
+	r = result.Failure[int](testErr)
+	message = result.Fold(r,
+		func(i int) string { return "ok" },
+		func(err error) string { return "err: " + err.Error() },
+	)
+	if message != "err: test error" {
+		t.Errorf("Expected 'err: test error', got %v", message)
+	}
+}
+
+func TestMapBoth(t *testing.T) {
+	// Test MapBoth with success
+	r := result.Success(42)
+	mapped := result.MapBoth(r,
+		func(i int) string { return "value" },
+		func(err error) error { return fmt.Errorf("wrapped: %w", err) },
+	)
+	if !mapped.IsSuccess() || mapped.GetSuccess() != "value" {
+		t.Errorf("Expected success 'value', got %v", mapped)
+	}
+
+	// Test MapBoth with failure
+	testErr := errors.New("test error")
+	r = result.Failure[int](testErr)
+	mapped = result.MapBoth(r,
+		func(i int) string { return "value" },
+		func(err error) error { return fmt.Errorf("wrapped: %w", err) },
+	)
+	if !mapped.IsFailure() || mapped.GetFailure().Error() != "wrapped: test error" {
+		t.Errorf("Expected failure 'wrapped: test error', got %v", mapped)
+	}
+}
+
+func TestMapError(t *testing.T) {
+	// Test MapError with failure
+	testErr := errors.New("test error")
+	r := result.Failure[int](testErr)
+	wrapped := result.MapError(r, func(err error) error {
+		return fmt.Errorf("context: %w", err)
+	})
+	if !wrapped.IsFailure() || wrapped.GetFailure().Error() != "context: test error" {
+		t.Errorf("Expected 'context: test error', got %v", wrapped.GetFailure())
+	}
+	if !errors.Is(wrapped.GetFailure(), testErr) {
+		t.Error("Expected wrapped error to satisfy errors.Is against the original error")
+	}
+
+	// Test MapError with success
+	r = result.Success(42)
+	wrapped = result.MapError(r, func(err error) error {
+		return fmt.Errorf("context: %w", err)
+	})
+	if !wrapped.IsSuccess() || wrapped.GetSuccess() != 42 {
+		t.Errorf("Expected success to be unchanged, got %v", wrapped)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	// Test Recover with failure
+	r := result.Failure[int](errors.New("test error"))
+	recovered := result.Recover(r, func(err error) int { return 0 })
+	if !recovered.IsSuccess() || recovered.GetSuccess() != 0 {
+		t.Errorf("Expected recovered success 0, got %v", recovered)
+	}
+
+	// Test Recover with success
+	r = result.Success(42)
+	recovered = result.Recover(r, func(err error) int { return 0 })
+	if !recovered.IsSuccess() || recovered.GetSuccess() != 42 {
+		t.Errorf("Expected success to be unchanged, got %v", recovered)
+	}
+}
+
+func TestRecoverWith(t *testing.T) {
+	// Test RecoverWith with failure recovering to success
+	r := result.Failure[int](errors.New("test error"))
+	recovered := result.RecoverWith(r, func(err error) result.Result[int] {
+		return result.Success(0)
+	})
+	if !recovered.IsSuccess() || recovered.GetSuccess() != 0 {
+		t.Errorf("Expected recovered success 0, got %v", recovered)
+	}
+
+	// Test RecoverWith with failure recovering to failure
+	fallbackErr := errors.New("fallback error")
+	r = result.Failure[int](errors.New("test error"))
+	recovered = result.RecoverWith(r, func(err error) result.Result[int] {
+		return result.Failure[int](fallbackErr)
+	})
+	if !recovered.IsFailure() || !errors.Is(recovered.GetFailure(), fallbackErr) {
+		t.Errorf("Expected fallback error, got %v", recovered)
+	}
+
+	// Test RecoverWith with success
+	r = result.Success(42)
+	recovered = result.RecoverWith(r, func(err error) result.Result[int] {
+		return result.Success(0)
+	})
+	if !recovered.IsSuccess() || recovered.GetSuccess() != 42 {
+		t.Errorf("Expected success to be unchanged, got %v", recovered)
+	}
+}
+
 func TestTry(t *testing.T) {
 	// Test with success case
 	successFunc := func() (int, error) {
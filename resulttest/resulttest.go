@@ -0,0 +1,146 @@
+// Package resulttest provides gotest.tools-style comparison helpers for
+// asserting on result.Result values in tests, producing rich go-cmp diffs
+// instead of hand-rolled equality checks.
+package resulttest
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sillen102/result"
+)
+
+// TestResult carries the outcome of a Comparison: whether it succeeded and,
+// if not, a human-readable failure message.
+type TestResult struct {
+	success bool
+	message string
+}
+
+// Success returns true if the comparison succeeded.
+func (r TestResult) Success() bool {
+	return r.success
+}
+
+// FailureMessage returns the human-readable reason the comparison failed.
+// It is empty when Success returns true.
+func (r TestResult) FailureMessage() string {
+	return r.message
+}
+
+func success() TestResult {
+	return TestResult{success: true}
+}
+
+func failure(format string, args ...any) TestResult {
+	return TestResult{message: fmt.Sprintf(format, args...)}
+}
+
+// Comparison is a function that performs a single comparison and returns its
+// TestResult. Comparisons are built by EqualSuccess, EqualFailure, and
+// IsFailureMatching, and run by Assert or Check.
+type Comparison func() TestResult
+
+// EqualSuccess returns a Comparison that succeeds if r is a success whose
+// value is deeply equal to want, using go-cmp with the given opts. If r is a
+// failure, or cmp.Diff panics (e.g. on unexported fields without an
+// appropriate cmp.Option), the Comparison fails with a descriptive message
+// rather than propagating the panic.
+func EqualSuccess[S any](r result.Result[S], want S, opts ...cmp.Option) Comparison {
+	return func() (result TestResult) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				result = failure("resulttest: panic comparing success value (likely unexported fields, add a cmp.Option): %v", rec)
+			}
+		}()
+
+		if r.IsFailure() {
+			return failure("expected a success value but got failure: %v", r.GetFailure())
+		}
+
+		diff := cmp.Diff(want, r.GetSuccess(), opts...)
+		if diff == "" {
+			return success()
+		}
+		return failure("success value does not match (-want +got):\n%s", diff)
+	}
+}
+
+// EqualFailure returns a Comparison that succeeds if r is a failure whose
+// error satisfies errors.Is(r.GetFailure(), wantErr).
+func EqualFailure[S any](r result.Result[S], wantErr error) Comparison {
+	return func() TestResult {
+		if r.IsSuccess() {
+			return failure("expected a failure matching %v but got success: %v", wantErr, r.GetSuccess())
+		}
+
+		if !errors.Is(r.GetFailure(), wantErr) {
+			return failure("failure %v does not match expected error %v", r.GetFailure(), wantErr)
+		}
+		return success()
+	}
+}
+
+// IsFailureMatching returns a Comparison that succeeds if r is a failure
+// whose error message matches pattern. pattern may be a plain string
+// (matched as a regular expression) or a *regexp.Regexp.
+func IsFailureMatching[S any](r result.Result[S], pattern any) Comparison {
+	return func() TestResult {
+		if r.IsSuccess() {
+			return failure("expected a failure matching %v but got success: %v", pattern, r.GetSuccess())
+		}
+
+		var re *regexp.Regexp
+		switch p := pattern.(type) {
+		case *regexp.Regexp:
+			re = p
+		case string:
+			compiled, err := regexp.Compile(p)
+			if err != nil {
+				return failure("resulttest: invalid pattern %q: %v", p, err)
+			}
+			re = compiled
+		default:
+			return failure("resulttest: pattern must be a string or *regexp.Regexp, got %T", pattern)
+		}
+
+		message := r.GetFailure().Error()
+		if !re.MatchString(message) {
+			return failure("failure message %q does not match pattern %q", message, re.String())
+		}
+		return success()
+	}
+}
+
+// Assert runs c and fails the test immediately via t.Fatal if it did not
+// succeed. The optional msg is prepended to the failure message.
+func Assert(t testing.TB, c Comparison, msg ...string) {
+	t.Helper()
+
+	if r := c(); !r.Success() {
+		t.Fatal(formatFailure(r, msg))
+	}
+}
+
+// Check runs c and reports a failure via t.Error if it did not succeed,
+// without stopping the test. It returns whether c succeeded. The optional
+// msg is prepended to the failure message.
+func Check(t testing.TB, c Comparison, msg ...string) bool {
+	t.Helper()
+
+	r := c()
+	if !r.Success() {
+		t.Error(formatFailure(r, msg))
+	}
+	return r.Success()
+}
+
+func formatFailure(r TestResult, msg []string) string {
+	if len(msg) == 0 {
+		return r.FailureMessage()
+	}
+	return fmt.Sprintf("%s: %s", msg[0], r.FailureMessage())
+}
@@ -0,0 +1,102 @@
+package resulttest_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sillen102/result"
+	"github.com/sillen102/result/resulttest"
+)
+
+type Person struct {
+	Name string
+	age  int
+}
+
+func TestEqualSuccess(t *testing.T) {
+	r := result.Success(42)
+
+	if c := resulttest.EqualSuccess(r, 42); !c().Success() {
+		t.Error("Expected EqualSuccess to succeed for matching values")
+	}
+
+	if c := resulttest.EqualSuccess(r, 43); c().Success() {
+		t.Error("Expected EqualSuccess to fail for mismatching values")
+	}
+}
+
+func TestEqualSuccessFailureResult(t *testing.T) {
+	r := result.Failure[int](errors.New("boom"))
+
+	c := resulttest.EqualSuccess(r, 42)
+	outcome := c()
+	if outcome.Success() {
+		t.Error("Expected EqualSuccess to fail when Result is a failure")
+	}
+	if !strings.Contains(outcome.FailureMessage(), "boom") {
+		t.Errorf("Expected failure message to mention the underlying error, got %q", outcome.FailureMessage())
+	}
+}
+
+func TestEqualSuccessUnexportedFieldPanicRecovery(t *testing.T) {
+	r := result.Success(Person{Name: "John", age: 30})
+
+	c := resulttest.EqualSuccess(r, Person{Name: "John", age: 30})
+	outcome := c()
+	if outcome.Success() {
+		t.Error("Expected EqualSuccess to fail without a cmp.Option for the unexported field")
+	}
+	if !strings.Contains(outcome.FailureMessage(), "panic") {
+		t.Errorf("Expected the recovered panic to be reported, got %q", outcome.FailureMessage())
+	}
+
+	withOpt := resulttest.EqualSuccess(r, Person{Name: "John", age: 30}, cmpopts.IgnoreUnexported(Person{}))
+	if !withOpt().Success() {
+		t.Error("Expected EqualSuccess to succeed once the unexported field is ignored")
+	}
+}
+
+func TestEqualFailure(t *testing.T) {
+	testErr := errors.New("test error")
+	r := result.Failure[int](testErr)
+
+	if c := resulttest.EqualFailure(r, testErr); !c().Success() {
+		t.Error("Expected EqualFailure to succeed for a matching error")
+	}
+
+	if c := resulttest.EqualFailure(r, errors.New("other error")); c().Success() {
+		t.Error("Expected EqualFailure to fail for a non-matching error")
+	}
+
+	if c := resulttest.EqualFailure(result.Success(42), testErr); c().Success() {
+		t.Error("Expected EqualFailure to fail when Result is a success")
+	}
+}
+
+func TestIsFailureMatching(t *testing.T) {
+	r := result.Failure[int](errors.New("record 42: not found"))
+
+	if c := resulttest.IsFailureMatching(r, "not found"); !c().Success() {
+		t.Error("Expected IsFailureMatching to succeed with a matching string pattern")
+	}
+
+	if c := resulttest.IsFailureMatching(r, `record \d+: not found`); !c().Success() {
+		t.Error("Expected IsFailureMatching to succeed with a matching regexp pattern")
+	}
+
+	if c := resulttest.IsFailureMatching(r, "does not match"); c().Success() {
+		t.Error("Expected IsFailureMatching to fail for a non-matching pattern")
+	}
+}
+
+func TestAssertAndCheck(t *testing.T) {
+	r := result.Success(42)
+
+	resulttest.Assert(t, resulttest.EqualSuccess(r, 42))
+
+	if !resulttest.Check(t, resulttest.EqualSuccess(r, 42)) {
+		t.Error("Expected Check to return true for a passing comparison")
+	}
+}
@@ -0,0 +1,125 @@
+package result
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+)
+
+// Value implements driver.Valuer, so a Result[S] can be passed directly as
+// a query argument. A failure is surfaced as the error returned to
+// database/sql, which aborts the query the same way a plain error would.
+//
+// If S implements driver.Valuer, that implementation is used. Otherwise the
+// success value is converted with driver.DefaultParameterConverter, the
+// same conversion database/sql applies to ordinary arguments.
+func (r Result[S]) Value() (driver.Value, error) {
+	if r.IsFailure() {
+		return nil, r.failure
+	}
+
+	if valuer, ok := any(r.success).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(r.success)
+}
+
+// Scan implements sql.Scanner, so a *Result[S] can be used directly as a
+// Scan destination. Unlike a plain Scan destination, a conversion failure
+// is never returned as an error: it is captured as a Failure so that one
+// bad column or row doesn't abort the caller's iteration. Scan always
+// returns nil.
+//
+// If *S implements sql.Scanner, that implementation is used. A nil src
+// scans as a Success holding the zero value of S.
+func (r *Result[S]) Scan(src any) error {
+	if src == nil {
+		var zero S
+		*r = Success(zero)
+		return nil
+	}
+
+	if scanner, ok := any(&r.success).(sql.Scanner); ok {
+		if err := scanner.Scan(src); err != nil {
+			*r = Failure[S](err)
+			return nil
+		}
+		*r = Success(r.success)
+		return nil
+	}
+
+	if v, ok := src.(S); ok {
+		*r = Success(v)
+		return nil
+	}
+
+	dest := reflect.New(reflect.TypeOf(&r.success).Elem())
+	srcValue := reflect.ValueOf(src)
+	if srcValue.Type().ConvertibleTo(dest.Elem().Type()) {
+		dest.Elem().Set(srcValue.Convert(dest.Elem().Type()))
+		*r = Success(dest.Elem().Interface().(S))
+		return nil
+	}
+
+	*r = Failure[S](fmt.Errorf("result: cannot scan %T into Result[%T]", src, r.success))
+	return nil
+}
+
+// ScanRows advances rows and applies scan to each row, collecting the
+// outcome into a Result[S]. Unlike calling rows.Scan in a hand-rolled loop,
+// a per-row error doesn't abort iteration: it is captured as a Failure so
+// the remaining rows are still scanned. If rows.Err returns a non-nil error
+// once iteration completes, it is appended as a final Failure.
+//
+// Example:
+//
+//	results := result.ScanRows(rows, func(rows *sql.Rows) (Person, error) {
+//		var p Person
+//		err := rows.Scan(&p.Name, &p.Age)
+//		return p, err
+//	})
+//	people, errs := result.CollectErrors(results)
+func ScanRows[S any](rows *sql.Rows, scan func(*sql.Rows) (S, error)) []Result[S] {
+	var results []Result[S]
+	for rows.Next() {
+		results = append(results, Try(scan(rows)))
+	}
+	if err := rows.Err(); err != nil {
+		results = append(results, Failure[S](err))
+	}
+	return results
+}
+
+// CollectErrors splits rs into the slice of successful values and the slice
+// of failure errors, discarding which position in rs each one came from.
+func CollectErrors[S any](rs []Result[S]) ([]S, []error) {
+	values := make([]S, 0, len(rs))
+	var errs []error
+	for _, r := range rs {
+		if r.IsFailure() {
+			errs = append(errs, r.GetFailure())
+			continue
+		}
+		values = append(values, r.GetSuccess())
+	}
+	return values, errs
+}
+
+// Partition splits rs into the slice of successful values and the slice of
+// failed Results. Unlike CollectErrors, each failure is kept as the
+// original Result[S] rather than unwrapped to an error, in case a caller
+// needs more than the error out of it.
+func Partition[S any](rs []Result[S]) ([]S, []Result[S]) {
+	successes := make([]S, 0, len(rs))
+	var failures []Result[S]
+	for _, r := range rs {
+		if r.IsFailure() {
+			failures = append(failures, r)
+			continue
+		}
+		successes = append(successes, r.GetSuccess())
+	}
+	return successes, failures
+}
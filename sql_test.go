@@ -0,0 +1,184 @@
+package result_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/sillen102/result"
+)
+
+func TestResultValueSuccess(t *testing.T) {
+	r := result.Success(42)
+
+	v, err := r.Value()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if v != int64(42) {
+		t.Errorf("Expected int64(42), got %v (%T)", v, v)
+	}
+}
+
+func TestResultValueFailure(t *testing.T) {
+	testErr := errors.New("invalid value")
+	r := result.Failure[int](testErr)
+
+	_, err := r.Value()
+	if !errors.Is(err, testErr) {
+		t.Errorf("Expected %v, got %v", testErr, err)
+	}
+}
+
+func TestResultScanNil(t *testing.T) {
+	var r result.Result[int]
+	if err := r.Scan(nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !r.IsSuccess() || r.GetSuccess() != 0 {
+		t.Errorf("Expected success 0, got %v", r)
+	}
+}
+
+func TestResultScanDirect(t *testing.T) {
+	var r result.Result[int64]
+	if err := r.Scan(int64(7)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !r.IsSuccess() || r.GetSuccess() != 7 {
+		t.Errorf("Expected success 7, got %v", r)
+	}
+}
+
+func TestResultScanConvertible(t *testing.T) {
+	var r result.Result[int]
+	if err := r.Scan(int64(7)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !r.IsSuccess() || r.GetSuccess() != 7 {
+		t.Errorf("Expected success 7, got %v", r)
+	}
+}
+
+func TestResultScanIncompatibleCapturesFailure(t *testing.T) {
+	var r result.Result[int]
+	if err := r.Scan("not a number"); err != nil {
+		t.Fatalf("Expected Scan to never return an error, got %v", err)
+	}
+
+	if !r.IsFailure() {
+		t.Errorf("Expected a Failure for an incompatible scan source, got %v", r)
+	}
+}
+
+// fakeDriver is a minimal database/sql/driver backend that returns a fixed
+// set of rows, one of which fails to convert, so ScanRows can be exercised
+// against a real *sql.Rows without a real database.
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return 0 }
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{values: []driver.Value{int64(1), int64(2), "boom"}}, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+	pos    int
+}
+
+func (r *fakeRows) Columns() []string { return []string{"n"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.pos]
+	r.pos++
+	return nil
+}
+
+func TestScanRowsCapturesPerRowErrors(t *testing.T) {
+	sql.Register("result-fake", fakeDriver{})
+	db, err := sql.Open("result-fake", "")
+	if err != nil {
+		t.Fatalf("Expected no error opening db, got %v", err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query("select n")
+	if err != nil {
+		t.Fatalf("Expected no error querying, got %v", err)
+	}
+	defer rows.Close()
+
+	results := result.ScanRows(rows, func(rows *sql.Rows) (int, error) {
+		var n int
+		err := rows.Scan(&n)
+		return n, err
+	})
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+
+	values, errs := result.CollectErrors(results)
+	if len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Errorf("Expected successes [1 2], got %v", values)
+	}
+	if len(errs) != 1 {
+		t.Errorf("Expected 1 error, got %v", errs)
+	}
+}
+
+func TestCollectErrors(t *testing.T) {
+	testErr := errors.New("bad row")
+	rs := []result.Result[int]{
+		result.Success(1),
+		result.Failure[int](testErr),
+		result.Success(2),
+	}
+
+	values, errs := result.CollectErrors(rs)
+	if len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Errorf("Expected [1 2], got %v", values)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], testErr) {
+		t.Errorf("Expected [%v], got %v", testErr, errs)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	testErr := errors.New("bad row")
+	failed := result.Failure[int](testErr)
+	rs := []result.Result[int]{result.Success(1), failed, result.Success(2)}
+
+	successes, failures := result.Partition(rs)
+	if len(successes) != 2 || successes[0] != 1 || successes[1] != 2 {
+		t.Errorf("Expected [1 2], got %v", successes)
+	}
+	if len(failures) != 1 || !failures[0].IsFailure() || !errors.Is(failures[0].GetFailure(), testErr) {
+		t.Errorf("Expected [%v], got %v", failed, failures)
+	}
+}
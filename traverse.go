@@ -0,0 +1,47 @@
+package result
+
+// Sequence turns a []Result[S] into a Result[[]S]: a success holding every
+// success value, in order, if every element of rs succeeded, or the first
+// failure encountered otherwise.
+//
+// Example:
+//
+//	rs := []result.Result[int]{result.Success(1), result.Success(2)}
+//	sequenced := result.Sequence(rs)
+//	// sequenced is Success([]int{1, 2})
+func Sequence[S any](rs []Result[S]) Result[[]S] {
+	values := make([]S, 0, len(rs))
+	for _, r := range rs {
+		if r.IsFailure() {
+			return Failure[[]S](r.GetFailure())
+		}
+		values = append(values, r.GetSuccess())
+	}
+	return Success(values)
+}
+
+// Traverse applies f to every element of xs and sequences the results: a
+// success holding every mapped value, in order, if f succeeded for all of
+// xs, or the first failure encountered otherwise. Traverse stops calling f
+// as soon as one call fails.
+//
+// Traverse is equivalent to mapping f over xs and calling Sequence on the
+// result, but fails fast instead of calling f for every element.
+//
+// Example:
+//
+//	parsed := result.Traverse([]string{"1", "2"}, func(s string) result.Result[int] {
+//		return result.Try(strconv.Atoi(s))
+//	})
+//	// parsed is Success([]int{1, 2})
+func Traverse[A, B any](xs []A, f func(A) Result[B]) Result[[]B] {
+	values := make([]B, 0, len(xs))
+	for _, x := range xs {
+		r := f(x)
+		if r.IsFailure() {
+			return Failure[[]B](r.GetFailure())
+		}
+		values = append(values, r.GetSuccess())
+	}
+	return Success(values)
+}
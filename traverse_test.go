@@ -0,0 +1,67 @@
+package result_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/sillen102/result"
+)
+
+func TestSequenceAllSuccess(t *testing.T) {
+	rs := []result.Result[int]{result.Success(1), result.Success(2), result.Success(3)}
+
+	sequenced := result.Sequence(rs)
+	if !sequenced.IsSuccess() {
+		t.Fatal("Expected Sequence to succeed")
+	}
+
+	got := sequenced.GetSuccess()
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+}
+
+func TestSequenceFailsFast(t *testing.T) {
+	testErr := errors.New("bad value")
+	rs := []result.Result[int]{
+		result.Success(1),
+		result.Failure[int](testErr),
+		result.Success(3),
+	}
+
+	sequenced := result.Sequence(rs)
+	if !sequenced.IsFailure() || !errors.Is(sequenced.GetFailure(), testErr) {
+		t.Errorf("Expected failure %v, got %v", testErr, sequenced)
+	}
+}
+
+func TestTraverseAllSuccess(t *testing.T) {
+	parsed := result.Traverse([]string{"1", "2", "3"}, func(s string) result.Result[int] {
+		return result.Try(strconv.Atoi(s))
+	})
+
+	if !parsed.IsSuccess() {
+		t.Fatal("Expected Traverse to succeed")
+	}
+
+	got := parsed.GetSuccess()
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+}
+
+func TestTraverseFailsFast(t *testing.T) {
+	calls := 0
+	parsed := result.Traverse([]string{"1", "x", "3"}, func(s string) result.Result[int] {
+		calls++
+		return result.Try(strconv.Atoi(s))
+	})
+
+	if !parsed.IsFailure() {
+		t.Errorf("Expected Traverse to fail, got %v", parsed)
+	}
+	if calls != 2 {
+		t.Errorf("Expected Traverse to stop after the first failure (2 calls), got %d calls", calls)
+	}
+}
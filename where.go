@@ -0,0 +1,259 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Ensure turns a successful Result into a failure if pred returns false for
+// the success value, using err as the failure value. A Result that is
+// already a failure is returned unchanged.
+//
+// Example:
+//
+//	r := result.Success(-5)
+//	ensured := result.Ensure(r, func(i int) bool { return i >= 0 }, errors.New("must be non-negative"))
+//	// ensured is Failure(errors.New("must be non-negative"))
+func Ensure[S any](r Result[S], pred func(S) bool, err error) Result[S] {
+	if r.IsFailure() {
+		return r
+	}
+	if !pred(r.GetSuccess()) {
+		return Failure[S](err)
+	}
+	return r
+}
+
+// Where filters a Result[[]S] down to the elements whose field, map key, or
+// no-argument method at path satisfies op against value, in the spirit of
+// Hugo's "where" template function.
+//
+// path is a dot-chained sequence of steps, e.g. "Params.series", navigating
+// struct fields, map keys and zero-argument methods, dereferencing pointers
+// and interfaces along the way. A step that hits a nil pointer/interface, an
+// unexported field, a missing map key, or an unknown method causes that
+// element to be skipped rather than erroring the whole Result.
+//
+// op is one of "==", "!=", "in" (value is a slice/array and the resolved
+// field must be one of its elements) or "matches" (value is a regexp
+// pattern matched against the resolved field's string form).
+//
+// A Result that is already a failure is returned unchanged.
+func Where[S any](r Result[[]S], path string, op string, value any) Result[[]S] {
+	if r.IsFailure() {
+		return r
+	}
+
+	items := r.GetSuccess()
+	filtered := make([]S, 0, len(items))
+	for _, item := range items {
+		fieldValue, ok := lookupPath(reflect.ValueOf(item), path)
+		if !ok {
+			continue
+		}
+		if matchesOp(fieldValue, op, value) {
+			filtered = append(filtered, item)
+		}
+	}
+	return Success(filtered)
+}
+
+// First returns a Result holding the first element of a successful
+// Result[[]S], or a failure if r is already a failure or its slice is empty.
+func First[S any](r Result[[]S]) Result[S] {
+	if r.IsFailure() {
+		return Failure[S](r.GetFailure())
+	}
+	items := r.GetSuccess()
+	if len(items) == 0 {
+		return Failure[S](errors.New("result: slice is empty"))
+	}
+	return Success(items[0])
+}
+
+// Pluck resolves path on every element of a successful Result[[]S] and
+// collects the results that are assignable to V into a Result[[]V]. Elements
+// where path cannot be resolved, or whose resolved value isn't assignable to
+// V, are skipped. A Result that is already a failure is returned unchanged.
+func Pluck[S, V any](r Result[[]S], path string) Result[[]V] {
+	if r.IsFailure() {
+		return Failure[[]V](r.GetFailure())
+	}
+
+	items := r.GetSuccess()
+	plucked := make([]V, 0, len(items))
+	for _, item := range items {
+		fieldValue, ok := lookupPath(reflect.ValueOf(item), path)
+		if !ok {
+			continue
+		}
+
+		var target V
+		targetType := reflect.TypeOf(&target).Elem()
+		if !fieldValue.IsValid() || !fieldValue.Type().AssignableTo(targetType) {
+			continue
+		}
+		plucked = append(plucked, fieldValue.Interface().(V))
+	}
+	return Success(plucked)
+}
+
+// lookupPath walks v one dot-separated step of path at a time, navigating
+// struct fields, map keys, and zero-argument methods. It reports ok=false if
+// any step hits a nil pointer/interface, an unexported field, a missing map
+// key, or an unresolvable step.
+func lookupPath(v reflect.Value, path string) (reflect.Value, bool) {
+	current := v
+	for _, step := range strings.Split(path, ".") {
+		deref, ok := indirect(current)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		current = deref
+
+		next, ok := resolveStep(current, step)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		current = next
+	}
+	return indirect(current)
+}
+
+// indirect dereferences pointers and interfaces, reporting ok=false if it
+// encounters a nil value along the way.
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	return v, true
+}
+
+func resolveStep(v reflect.Value, step string) (reflect.Value, bool) {
+	switch v.Kind() {
+	case reflect.Struct:
+		if field := v.FieldByName(step); field.IsValid() {
+			if !field.CanInterface() {
+				// Unexported field: skip cleanly rather than panicking.
+				return reflect.Value{}, false
+			}
+			return field, true
+		}
+		if method := methodByName(v, step); method.IsValid() {
+			return callNoArgMethod(method)
+		}
+		return reflect.Value{}, false
+	case reflect.Map:
+		key := reflect.ValueOf(step)
+		if !key.Type().AssignableTo(v.Type().Key()) {
+			return reflect.Value{}, false
+		}
+		value := v.MapIndex(key)
+		if !value.IsValid() {
+			return reflect.Value{}, false
+		}
+		return value, true
+	default:
+		if method := methodByName(v, step); method.IsValid() {
+			return callNoArgMethod(method)
+		}
+		return reflect.Value{}, false
+	}
+}
+
+func methodByName(v reflect.Value, name string) reflect.Value {
+	if method := v.MethodByName(name); method.IsValid() {
+		return method
+	}
+	if v.CanAddr() {
+		return v.Addr().MethodByName(name)
+	}
+	return reflect.Value{}
+}
+
+func callNoArgMethod(method reflect.Value) (reflect.Value, bool) {
+	methodType := method.Type()
+	if methodType.NumIn() != 0 || methodType.NumOut() != 1 {
+		return reflect.Value{}, false
+	}
+	return method.Call(nil)[0], true
+}
+
+func matchesOp(fieldValue reflect.Value, op string, value any) bool {
+	switch op {
+	case "==":
+		return valuesEqual(fieldValue, value)
+	case "!=":
+		return !valuesEqual(fieldValue, value)
+	case "in":
+		return valueIn(fieldValue, value)
+	case "matches":
+		pattern, ok := value.(string)
+		if !ok {
+			return false
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false
+		}
+		return re.MatchString(stringOf(fieldValue))
+	default:
+		return false
+	}
+}
+
+func valuesEqual(fieldValue reflect.Value, value any) bool {
+	if !fieldValue.IsValid() {
+		return value == nil
+	}
+	if other := reflect.ValueOf(value); other.IsValid() && other.Type() == fieldValue.Type() {
+		// Slices, maps and funcs aren't comparable with ==; fall back to a
+		// deep comparison instead of letting it panic.
+		if !fieldValue.Comparable() {
+			return reflect.DeepEqual(fieldValue.Interface(), other.Interface())
+		}
+		return fieldValue.Interface() == other.Interface()
+	}
+	return stringOf(fieldValue) == stringOf(reflect.ValueOf(value))
+}
+
+func valueIn(fieldValue reflect.Value, value any) bool {
+	candidates := reflect.ValueOf(value)
+	if candidates.Kind() != reflect.Slice && candidates.Kind() != reflect.Array {
+		return false
+	}
+	for i := 0; i < candidates.Len(); i++ {
+		if valuesEqual(fieldValue, candidates.Index(i).Interface()) {
+			return true
+		}
+	}
+	return false
+}
+
+func stringOf(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	if v.CanInterface() {
+		if stringer, ok := v.Interface().(interface{ String() string }); ok {
+			return stringer.String()
+		}
+	}
+	return reflectValueToString(v)
+}
+
+func reflectValueToString(v reflect.Value) string {
+	if v.Kind() == reflect.String {
+		return v.String()
+	}
+	if !v.CanInterface() {
+		return ""
+	}
+	return fmt.Sprint(v.Interface())
+}
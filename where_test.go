@@ -0,0 +1,207 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sillen102/result"
+)
+
+type queryPerson struct {
+	Name   string
+	Age    int
+	Params queryParams
+	Tags   []string
+	admin  bool
+}
+
+type queryParams struct {
+	Series string
+}
+
+func (p queryPerson) DisplayName() string {
+	return "Mx. " + p.Name
+}
+
+func TestEnsure(t *testing.T) {
+	// Test Ensure with a passing predicate
+	r := result.Success(5)
+	ensured := result.Ensure(r, func(i int) bool { return i > 0 }, errors.New("must be positive"))
+	if !ensured.IsSuccess() || ensured.GetSuccess() != 5 {
+		t.Errorf("Expected success to be unchanged, got %v", ensured)
+	}
+
+	// Test Ensure with a failing predicate
+	testErr := errors.New("must be positive")
+	r = result.Success(-5)
+	ensured = result.Ensure(r, func(i int) bool { return i > 0 }, testErr)
+	if !ensured.IsFailure() || !errors.Is(ensured.GetFailure(), testErr) {
+		t.Errorf("Expected failure %v, got %v", testErr, ensured)
+	}
+
+	// Test Ensure with an already-failed Result
+	initialErr := errors.New("initial error")
+	r = result.Failure[int](initialErr)
+	ensured = result.Ensure(r, func(i int) bool { return i > 0 }, testErr)
+	if !ensured.IsFailure() || !errors.Is(ensured.GetFailure(), initialErr) {
+		t.Errorf("Expected the original failure to be preserved, got %v", ensured)
+	}
+}
+
+func TestWhereStructField(t *testing.T) {
+	people := result.Success([]queryPerson{
+		{Name: "Ann", Age: 30},
+		{Name: "Bo", Age: 25},
+		{Name: "Cy", Age: 30},
+	})
+
+	filtered := result.Where(people, "Age", "==", 30)
+	if !filtered.IsSuccess() {
+		t.Fatal("Expected Where to succeed")
+	}
+
+	got := filtered.GetSuccess()
+	if len(got) != 2 || got[0].Name != "Ann" || got[1].Name != "Cy" {
+		t.Errorf("Expected Ann and Cy, got %v", got)
+	}
+}
+
+func TestWhereNestedPath(t *testing.T) {
+	people := result.Success([]queryPerson{
+		{Name: "Ann", Params: queryParams{Series: "a"}},
+		{Name: "Bo", Params: queryParams{Series: "b"}},
+	})
+
+	filtered := result.Where(people, "Params.Series", "==", "b")
+	got := filtered.GetSuccess()
+	if len(got) != 1 || got[0].Name != "Bo" {
+		t.Errorf("Expected only Bo, got %v", got)
+	}
+}
+
+func TestWhereOperators(t *testing.T) {
+	people := result.Success([]queryPerson{
+		{Name: "Ann", Age: 30},
+		{Name: "Bo", Age: 25},
+		{Name: "Cy", Age: 40},
+	})
+
+	neq := result.Where(people, "Age", "!=", 30)
+	if got := neq.GetSuccess(); len(got) != 2 {
+		t.Errorf("Expected 2 results for !=, got %v", got)
+	}
+
+	in := result.Where(people, "Age", "in", []int{25, 40})
+	if got := in.GetSuccess(); len(got) != 2 || got[0].Name != "Bo" || got[1].Name != "Cy" {
+		t.Errorf("Expected Bo and Cy for in, got %v", got)
+	}
+
+	matches := result.Where(people, "Name", "matches", "^A")
+	if got := matches.GetSuccess(); len(got) != 1 || got[0].Name != "Ann" {
+		t.Errorf("Expected only Ann for matches, got %v", got)
+	}
+}
+
+func TestWhereUncomparableField(t *testing.T) {
+	people := result.Success([]queryPerson{
+		{Name: "Ann", Tags: []string{"a"}},
+		{Name: "Bo", Tags: []string{"b"}},
+	})
+
+	// Tags is a slice, which isn't comparable with ==; Where must fall back
+	// to a deep comparison instead of panicking.
+	filtered := result.Where(people, "Tags", "==", []string{"a"})
+	if got := filtered.GetSuccess(); len(got) != 1 || got[0].Name != "Ann" {
+		t.Errorf("Expected only Ann, got %v", got)
+	}
+
+	notEqual := result.Where(people, "Tags", "!=", []string{"a"})
+	if got := notEqual.GetSuccess(); len(got) != 1 || got[0].Name != "Bo" {
+		t.Errorf("Expected only Bo, got %v", got)
+	}
+
+	in := result.Where(people, "Tags", "in", [][]string{{"b"}})
+	if got := in.GetSuccess(); len(got) != 1 || got[0].Name != "Bo" {
+		t.Errorf("Expected only Bo, got %v", got)
+	}
+}
+
+func TestWhereMethodAndUnexportedField(t *testing.T) {
+	people := result.Success([]queryPerson{
+		{Name: "Ann", admin: true},
+		{Name: "Bo", admin: false},
+	})
+
+	// DisplayName is a zero-arg method and should be resolvable.
+	byDisplayName := result.Where(people, "DisplayName", "==", "Mx. Ann")
+	if got := byDisplayName.GetSuccess(); len(got) != 1 || got[0].Name != "Ann" {
+		t.Errorf("Expected only Ann via method path, got %v", got)
+	}
+
+	// admin is unexported and should be skipped cleanly, producing no matches.
+	byUnexported := result.Where(people, "admin", "==", true)
+	if got := byUnexported.GetSuccess(); len(got) != 0 {
+		t.Errorf("Expected unexported field path to match nothing, got %v", got)
+	}
+}
+
+func TestWherePreservesFailure(t *testing.T) {
+	testErr := errors.New("test error")
+	r := result.Failure[[]queryPerson](testErr)
+
+	filtered := result.Where(r, "Age", "==", 30)
+	if !filtered.IsFailure() || !errors.Is(filtered.GetFailure(), testErr) {
+		t.Errorf("Expected failure to be preserved, got %v", filtered)
+	}
+}
+
+func TestFirst(t *testing.T) {
+	// Test First with a non-empty slice
+	people := result.Success([]queryPerson{{Name: "Ann"}, {Name: "Bo"}})
+	first := result.First(people)
+	if !first.IsSuccess() || first.GetSuccess().Name != "Ann" {
+		t.Errorf("Expected first element to be Ann, got %v", first)
+	}
+
+	// Test First with an empty slice
+	empty := result.Success([]queryPerson{})
+	first = result.First(empty)
+	if !first.IsFailure() {
+		t.Error("Expected First to fail on an empty slice")
+	}
+
+	// Test First with a failure
+	testErr := errors.New("test error")
+	failed := result.Failure[[]queryPerson](testErr)
+	first = result.First(failed)
+	if !first.IsFailure() || !errors.Is(first.GetFailure(), testErr) {
+		t.Errorf("Expected original failure to be preserved, got %v", first)
+	}
+}
+
+func TestPluck(t *testing.T) {
+	people := result.Success([]queryPerson{
+		{Name: "Ann", Age: 30},
+		{Name: "Bo", Age: 25},
+	})
+
+	names := result.Pluck[queryPerson, string](people, "Name")
+	if !names.IsSuccess() {
+		t.Fatal("Expected Pluck to succeed")
+	}
+
+	got := names.GetSuccess()
+	if len(got) != 2 || got[0] != "Ann" || got[1] != "Bo" {
+		t.Errorf("Expected [Ann Bo], got %v", got)
+	}
+}
+
+func TestPluckPreservesFailure(t *testing.T) {
+	testErr := errors.New("test error")
+	r := result.Failure[[]queryPerson](testErr)
+
+	plucked := result.Pluck[queryPerson, string](r, "Name")
+	if !plucked.IsFailure() || !errors.Is(plucked.GetFailure(), testErr) {
+		t.Errorf("Expected failure to be preserved, got %v", plucked)
+	}
+}